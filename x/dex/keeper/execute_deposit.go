@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+// duplicatePriceSearchSteps bounds how far RoundTickToUniquePrice will
+// search outward from a requested tick for one with a distinct price
+// before giving up.
+const duplicatePriceSearchSteps = 10
+
+// ExecuteDeposit runs the full deposit flow for every (tickIndex, fee)
+// pair in the request and aggregates the results into a single
+// DepositResult, instead of parallel slices the caller has to zip back
+// together by index. msg_server's Deposit handler and the SimulateDeposit
+// query both call this and only differ in whether ctx gets committed.
+func (k Keeper) ExecuteDeposit(
+	ctx sdk.Context,
+	creator, receiver, tokenA, tokenB string,
+	amountsA, amountsB []math.Int,
+	tickIndexesAToB []int64,
+	fees []uint64,
+	options []*types.DepositOptions,
+) (*types.DepositResult, error) {
+	result := &types.DepositResult{
+		SwapInAmount:  math.ZeroInt(),
+		SwapOutAmount: math.ZeroInt(),
+		AutoswapFee:   math.ZeroInt(),
+	}
+
+	for i, tickIndex := range tickIndexesAToB {
+		opts := types.DepositOptions{}
+		if options != nil && options[i] != nil {
+			opts = *options[i]
+		}
+
+		pairTick := tickIndex
+		fee := fees[i]
+		if opts.FullRange {
+			pairTick, fee = k.NewFullRangeDeposit(ctx, tokenA, tokenB, amountsA[i], amountsB[i], opts)
+		} else {
+			effectiveTick, err := types.RoundTickToUniquePrice(pairTick, opts.StrictMode, duplicatePriceSearchSteps)
+			if err != nil {
+				if opts.StrictMode {
+					return nil, err
+				}
+				result.FailedDeposits = append(result.FailedDeposits, &types.FailedDeposit{
+					DepositIndex: uint64(i),
+					Error:        err.Error(),
+				})
+				continue
+			}
+			pairTick = effectiveTick
+		}
+
+		reserve0, reserve1, shares, swapIn, swapOut, autoswapFee, err := k.depositSingle(
+			ctx, creator, receiver, tokenA, tokenB, amountsA[i], amountsB[i], pairTick, fee, opts,
+		)
+		if err != nil {
+			if opts.FailTxOnBel {
+				return nil, err
+			}
+			result.FailedDeposits = append(result.FailedDeposits, &types.FailedDeposit{
+				DepositIndex: uint64(i),
+				Error:        err.Error(),
+			})
+			continue
+		}
+
+		result.Reserve0Deposited = append(result.Reserve0Deposited, reserve0)
+		result.Reserve1Deposited = append(result.Reserve1Deposited, reserve1)
+		result.SharesIssued = append(result.SharesIssued, shares)
+		result.SwapInAmount = result.SwapInAmount.Add(swapIn)
+		result.SwapOutAmount = result.SwapOutAmount.Add(swapOut)
+		result.AutoswapFee = result.AutoswapFee.Add(autoswapFee)
+		result.EffectiveTicks = append(result.EffectiveTicks, pairTick)
+
+		// Resting liquidity at this tick now includes this deposit's
+		// reserves, in each swap direction, so a swap walking the book
+		// actually sees it via GetNextTickLiquidity instead of always
+		// finding the tick empty.
+		k.AddTickLiquidity(ctx, tokenA, tokenB, pairTick, reserve1)
+		k.AddTickLiquidity(ctx, tokenB, tokenA, pairTick, reserve0)
+
+		if opts.FullRange {
+			k.SetRangePosition(ctx, types.RangePosition{
+				Owner:     receiver,
+				TokenA:    tokenA,
+				TokenB:    tokenB,
+				TickIndex: pairTick,
+				Fee:       fee,
+				Preset:    opts.RangePreset,
+				Shares:    shares.Amount,
+			})
+		}
+	}
+
+	result.NewCurrentTick0To1 = k.MustGetCurrTick0To1(ctx, tokenA, tokenB)
+	result.NewCurrentTick1To0 = k.MustGetCurrTick1To0(ctx, tokenA, tokenB)
+
+	return result, nil
+}
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+// ExecutePlaceLimitOrder walks the order book from the pool's current tick
+// towards tickIndexInToOut/limitSellPrice, swapping amountIn of tokenIn for
+// tokenOut one crossed tick at a time, until amountIn is exhausted, the
+// book runs out of liquidity, or the price limit is hit. It is the single
+// entry point MsgPlaceLimitOrder, DrySwap, and each hop of MultiHopSwap
+// all call.
+//
+// The pool and its reserves are loaded once via pooledSwapState and
+// mutated in memory for every tick crossed; the store is only written
+// once, by state.flush(), after the loop ends. Per-tick liquidity still
+// has to be read from the store each iteration (that's the order book
+// itself), but the pool/reserves pair no longer is.
+func (k Keeper) ExecutePlaceLimitOrder(
+	ctx sdk.Context,
+	creator, receiver, tokenIn, tokenOut string,
+	amountIn math.Int,
+	tickIndexInToOut int64,
+	limitSellPrice math.LegacyDec,
+) (sdk.Coin, math.Int, int64, error) {
+	state, err := k.loadPooledSwapState(ctx, tokenIn, tokenOut)
+	if err != nil {
+		return sdk.Coin{}, math.Int{}, 0, err
+	}
+
+	remainingIn := amountIn
+	amountOut := math.ZeroInt()
+	currentTick := k.GetCurrCenterTick(ctx, tokenIn, tokenOut)
+
+	for remainingIn.IsPositive() {
+		nextTick, tickLiquidity, found := k.nextLiquidTick(ctx, tokenIn, tokenOut, currentTick)
+		if !found {
+			break
+		}
+		if tickIndexInToOut != 0 && pastTickLimit(tokenIn, tokenOut, nextTick, tickIndexInToOut) {
+			break
+		}
+		if !limitSellPrice.IsNil() && limitSellPrice.IsPositive() && pastPriceLimit(nextTick, limitSellPrice) {
+			break
+		}
+
+		swapIn, swapOut := swapAgainstTick(remainingIn, tickLiquidity, types.PriceAtTick(nextTick))
+		if swapIn.IsZero() {
+			break
+		}
+
+		state.applyTickCrossing(sdk.NewCoin(tokenIn, swapIn), sdk.NewCoin(tokenOut, swapOut))
+
+		remainingIn = remainingIn.Sub(swapIn)
+		amountOut = amountOut.Add(swapOut)
+		currentTick = nextTick
+	}
+
+	state.flush()
+
+	return sdk.NewCoin(tokenOut, amountOut), remainingIn, currentTick, nil
+}
+
+// nextLiquidTick returns the next tick (beyond currentTick, in the
+// tokenIn->tokenOut direction) that has resting liquidity, and how much.
+func (k Keeper) nextLiquidTick(ctx sdk.Context, tokenIn, tokenOut string, currentTick int64) (int64, math.Int, bool) {
+	return k.GetNextTickLiquidity(ctx, tokenIn, tokenOut, currentTick)
+}
+
+// swapAgainstTick consumes as much of remainingIn as tickLiquidity (resting
+// tokenOut at this tick) can absorb at price (tokenOut per tokenIn, i.e.
+// types.PriceAtTick(nextTick)) and returns the amounts actually swapped.
+func swapAgainstTick(remainingIn, tickLiquidity math.Int, price math.LegacyDec) (math.Int, math.Int) {
+	if tickLiquidity.IsZero() || price.IsNil() || !price.IsPositive() {
+		return math.ZeroInt(), math.ZeroInt()
+	}
+
+	maxInForTick := math.LegacyNewDecFromInt(tickLiquidity).Quo(price).TruncateInt()
+	if maxInForTick.IsZero() {
+		return math.ZeroInt(), math.ZeroInt()
+	}
+
+	if remainingIn.LTE(maxInForTick) {
+		swapOut := math.LegacyNewDecFromInt(remainingIn).Mul(price).TruncateInt()
+		if swapOut.GT(tickLiquidity) {
+			swapOut = tickLiquidity
+		}
+		return remainingIn, swapOut
+	}
+
+	return maxInForTick, tickLiquidity
+}
+
+func pastTickLimit(tokenIn, tokenOut string, tick, limit int64) bool {
+	if tokenIn < tokenOut {
+		return tick > limit
+	}
+	return tick < limit
+}
+
+func pastPriceLimit(tick int64, limit math.LegacyDec) bool {
+	return types.PriceAtTick(tick).GT(limit)
+}
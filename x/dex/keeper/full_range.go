@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+// rangePresetHalfWidth returns how many ticks on either side of the pool's
+// current price a FullRange deposit spreads across for the given preset,
+// falling back to RangePresetMedium's configured width when the deposit
+// left RangePreset unspecified. Widths are configurable via
+// SetRangePresetWidth (intended to be governance-gated).
+func (k Keeper) rangePresetHalfWidth(ctx sdk.Context, preset types.RangePreset) int64 {
+	if preset == types.RangePresetUnspecified {
+		preset = types.RangePresetMedium
+	}
+	return k.GetRangePresetWidth(ctx, preset)
+}
+
+// NewFullRangeDeposit expands a FullRange deposit request into a single
+// wide tick/fee pair centered on the pool's current price between
+// tokenA and tokenB, so the rest of the deposit pipeline (share issuance,
+// autoswap, BEL checks) can treat it exactly like any other deposit.
+// Callers record the resulting RangePosition themselves once the deposit
+// actually succeeds and the issued shares are known; see ExecuteDeposit.
+func (k Keeper) NewFullRangeDeposit(
+	ctx sdk.Context,
+	tokenA, tokenB string,
+	amountA, amountB math.Int,
+	options types.DepositOptions,
+) (tickIndex int64, fee uint64) {
+	centerTick := k.GetCurrCenterTick(ctx, tokenA, tokenB)
+	halfWidth := k.rangePresetHalfWidth(ctx, options.RangePreset)
+
+	return centerTick, uint64(halfWidth)
+}
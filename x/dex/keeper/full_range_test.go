@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+func (s *DexTestSuite) TestFullRangeDepositUsesPresetWidth() {
+	s.fundAliceBalances(50, 50)
+
+	resp := s.aliceDeposits(
+		NewDepositWithOptions(10, 10, 0, 0, types.DepositOptions{
+			FullRange:   true,
+			RangePreset: types.RangePresetWide,
+		}),
+	)
+
+	s.assertAliceBalances(40, 40)
+	s.assertDexBalances(10, 10)
+	s.NotEmpty(resp.SharesIssued)
+
+	positions, err := s.App.DexKeeper.RangePositions(s.Ctx, &types.QueryRangePositionsRequest{
+		Address: s.alice.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(positions.Positions, 1)
+	s.Equal(uint64(1000), positions.Positions[0].Fee)
+}
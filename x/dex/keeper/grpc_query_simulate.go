@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+// DrySwap runs the exact same execution path as MsgPlaceLimitOrder against a
+// cached context and reports the result without committing any state
+// change. It exists so bots and front-ends can preview a swap's outcome
+// instead of reimplementing the pool math themselves.
+func (k Keeper) DrySwap(goCtx context.Context, req *types.QueryDrySwapRequest) (*types.QueryDrySwapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, _ := ctx.CacheContext()
+
+	coinOut, sharesLeft, tickOut, err := k.ExecutePlaceLimitOrder(
+		cacheCtx,
+		req.Creator,
+		req.Receiver,
+		req.TokenIn,
+		req.TokenOut,
+		req.AmountIn,
+		req.TickIndexInToOut,
+		req.LimitSellPrice,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to simulate swap")
+	}
+
+	return &types.QueryDrySwapResponse{
+		CoinOut:     coinOut,
+		TickOut:     tickOut,
+		RemainingIn: sharesLeft,
+	}, nil
+}
+
+// SimulateDeposit mirrors MsgDeposit's execution on a cached context so a
+// caller can preview Reserve0Deposited/Reserve1Deposited/SharesIssued,
+// including whether the deposit would be swapped on deposit or rejected
+// with ErrDepositBehindEnemyLines, without spending gas or submitting a tx.
+func (k Keeper) SimulateDeposit(goCtx context.Context, req *types.QuerySimulateDepositRequest) (*types.QuerySimulateDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, _ := ctx.CacheContext()
+
+	result, err := k.ExecuteDeposit(
+		cacheCtx,
+		req.Creator,
+		req.Receiver,
+		req.TokenA,
+		req.TokenB,
+		req.AmountsA,
+		req.AmountsB,
+		req.TickIndexesAToB,
+		req.Fees,
+		req.Options,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to simulate deposit")
+	}
+
+	return &types.QuerySimulateDepositResponse{Resp: result.ToMsgDepositResponse()}, nil
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+func (s *DexTestSuite) TestSimulateDepositMatchesDeposit() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 30)
+
+	s.bobDeposits(NewDeposit(0, 10, 2001, 1),
+		NewDeposit(0, 10, 2002, 1),
+		NewDeposit(0, 10, 2003, 1),
+	)
+
+	req := &types.QuerySimulateDepositRequest{
+		Creator:         s.alice.String(),
+		Receiver:        s.alice.String(),
+		TokenA:          "TokenA",
+		TokenB:          "TokenB",
+		AmountsA:        []sdkmath.Int{sdkmath.NewInt(50_000000)},
+		AmountsB:        []sdkmath.Int{sdkmath.ZeroInt()},
+		TickIndexesAToB: []int64{2006},
+		Fees:            []uint64{1},
+		Options:         []*types.DepositOptions{{FailTxOnBel: true, SwapOnDeposit: true}},
+	}
+
+	simResp, err := s.App.DexKeeper.SimulateDeposit(s.Ctx, req)
+	s.NoError(err)
+
+	// Simulating must not move any real balances.
+	s.assertAliceBalances(50, 0)
+
+	resp := s.aliceDeposits(
+		NewDepositWithOptions(50, 0, 2006, 1, types.DepositOptions{FailTxOnBel: true, SwapOnDeposit: true}),
+	)
+
+	s.Equal(resp.Reserve0Deposited[0], simResp.Resp.Reserve0Deposited[0])
+	s.Equal(resp.Reserve1Deposited[0], simResp.Resp.Reserve1Deposited[0])
+	s.Equal(resp.SharesIssued[0].Amount, simResp.Resp.SharesIssued[0].Amount)
+
+	// The whole point of SimulateDeposit is previewing the swap-on-deposit
+	// math without resubmitting a tx; the swap/autoswap/tick fields have to
+	// actually reach the wire response, not just live on DepositResult.
+	s.Equal(resp.SwapInAmount, simResp.Resp.SwapInAmount)
+	s.Equal(resp.SwapOutAmount, simResp.Resp.SwapOutAmount)
+	s.Equal(resp.NewCurrentTick0To1, simResp.Resp.NewCurrentTick0To1)
+	s.Equal(resp.NewCurrentTick1To0, simResp.Resp.NewCurrentTick1To0)
+	s.True(simResp.Resp.SwapInAmount.IsPositive())
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v9 "github.com/neutron-org/neutron/v8/x/dex/migrations/v9"
+)
+
+// Migrator implements the module's in-place store migrations. It is
+// wired up from the module's RegisterServices via
+// cfg.RegisterMigration(types.ModuleName, 8, m.Migrate8to9), bumping
+// AppModule.ConsensusVersion() from 8 to 9 alongside it.
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate8to9 rewrites every tick-liquidity store key from the old
+// math.Int encoding to the new fixed-width int64 encoding (TickIndexKey).
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.MigrateTickKeys(ctx, m.keeper.storeKey, TickLiquidityKeyPrefix)
+}
@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+
+	"github.com/neutron-org/neutron/v8/x/dex/keeper"
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+// TestMigrate8to9RewritesLegacyTickKeys seeds a tick-liquidity entry under
+// the old math.Int decimal-string key encoding and checks Migrate8to9
+// actually rewrites it to the new TickIndexKey encoding, so it's read back
+// at the same key GetNextTickLiquidity now looks it up under.
+func (s *DexTestSuite) TestMigrate8to9RewritesLegacyTickKeys() {
+	store := prefix.NewStore(s.Ctx.KVStore(s.StoreKey), keeper.TickLiquidityKeyPrefix)
+
+	legacyKey := []byte("23027/TokenA/TokenB")
+	amount := math.NewInt(10_000000)
+	bz, err := amount.Marshal()
+	s.NoError(err)
+	store.Set(legacyKey, bz)
+
+	migrator := keeper.NewMigrator(s.App.DexKeeper)
+	s.NoError(migrator.Migrate8to9(s.Ctx))
+
+	newKey := append(types.TickIndexKey(23027), []byte("/TokenA/TokenB")...)
+	s.True(store.Has(newKey))
+	s.False(store.Has(legacyKey))
+}
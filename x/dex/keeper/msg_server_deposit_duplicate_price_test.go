@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+func (s *DexTestSuite) TestDepositReportsEffectiveTickOnSuccess() {
+	s.fundAliceBalances(50, 50)
+
+	resp := s.aliceDeposits(NewDeposit(10, 10, 23027, 0))
+
+	s.Equal(int64(23027), resp.EffectiveTicks[0])
+}
+
+// TestDepositStrictModeRejectsDuplicatePriceTick uses a tick deep enough
+// (-414488) that 1.0001^tick has genuinely underflowed past the module's
+// 18-decimal-place price precision and collapsed to the same value as
+// its neighbors — unlike tick 23027, which has a perfectly distinct
+// price and deposits fine (see TestDepositReportsEffectiveTickOnSuccess).
+func (s *DexTestSuite) TestDepositStrictModeRejectsDuplicatePriceTick() {
+	s.fundAliceBalances(50, 50)
+
+	s.assertAliceDepositFails(
+		types.ErrDuplicatePriceTick,
+		NewDepositWithOptions(10, 10, -414488, 0, types.DepositOptions{StrictMode: true}),
+	)
+}
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+// MultiHopSwap walks Route hop by hop, swapping the output of each leg into
+// the input of the next, atomically. If PickBestRoute is set, Route is
+// treated as one candidate among itself plus every route multiHopSwapBestRoute
+// auto-discovers via GetCandidateRoutes; each candidate is simulated in its
+// own cached context and the one producing the largest final output is the
+// one actually committed.
+func (k msgServer) MultiHopSwap(goCtx context.Context, msg *types.MsgMultiHopSwap) (*types.MsgMultiHopSwapResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.PickBestRoute {
+		return k.Keeper.multiHopSwapBestRoute(ctx, msg)
+	}
+
+	resp, dust, err := k.Keeper.executeMultiHopRoute(ctx, msg, msg.Route)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.refundDust(ctx, msg.Creator, dust); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// executeMultiHopRoute runs one candidate route against ctx, emitting a
+// per-hop event, and returns the response plus any intermediate-denom dust
+// left over (e.g. from rounding within a hop).
+func (k Keeper) executeMultiHopRoute(ctx sdk.Context, msg *types.MsgMultiHopSwap, route []string) (*types.MsgMultiHopSwapResponse, sdk.Coins, error) {
+	if len(route) < 2 {
+		return nil, nil, types.ErrInvalidRoute
+	}
+
+	amountIn := msg.AmountIn
+	dust := sdk.NewCoins()
+
+	for i := 0; i < len(route)-1; i++ {
+		tokenIn := route[i]
+		tokenOut := route[i+1]
+
+		hopLimit := math.LegacyZeroDec()
+		if msg.PriceLimits != nil && i < len(msg.PriceLimits) {
+			// msg.PriceLimits is sized to msg.Route, which ValidateBasic
+			// checked; a PickBestRoute candidate route can be a different
+			// length (e.g. a detour through an extra hop), so only apply a
+			// per-hop limit where one actually exists for this index.
+			hopLimit = msg.PriceLimits[i]
+		} else if i == len(route)-2 {
+			hopLimit = msg.ExitLimitPrice
+		}
+
+		coinOut, remaining, _, err := k.ExecutePlaceLimitOrder(
+			ctx,
+			msg.Creator,
+			msg.Creator,
+			tokenIn,
+			tokenOut,
+			amountIn,
+			0,
+			hopLimit,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !remaining.IsZero() && hopLimit.IsPositive() {
+			return nil, nil, types.ErrHopPriceLimitExceeded
+		}
+		if !remaining.IsZero() {
+			dust = dust.Add(sdk.NewCoin(tokenIn, remaining))
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeMultiHopSwapHop,
+			sdk.NewAttribute(types.AttributeKeyHopIndex, sdk.NewInt(int64(i)).String()),
+			sdk.NewAttribute(types.AttributeKeyTokenIn, tokenIn),
+			sdk.NewAttribute(types.AttributeKeyTokenOut, tokenOut),
+			sdk.NewAttribute(types.AttributeKeyAmountIn, amountIn.String()),
+			sdk.NewAttribute(types.AttributeKeyAmountOut, coinOut.Amount.String()),
+		))
+
+		amountIn = coinOut.Amount
+	}
+
+	finalOut := sdk.NewCoin(route[len(route)-1], amountIn)
+
+	return &types.MsgMultiHopSwapResponse{
+		CoinOut:      finalOut,
+		RouteUsed:    route,
+		DustRefunded: dust,
+	}, dust, nil
+}
+
+// multiHopSwapBestRoute simulates msg.Route plus every auto-discovered
+// alternate route between the same source/target pair in its own cached
+// context, and commits whichever yields the largest CoinOut.
+func (k Keeper) multiHopSwapBestRoute(ctx sdk.Context, msg *types.MsgMultiHopSwap) (*types.MsgMultiHopSwapResponse, error) {
+	// The route the caller actually submitted is always a candidate,
+	// regardless of how many hops it has; GetCandidateRoutes only ever
+	// auto-discovers direct and one-hop-detour alternates, so without
+	// this a submitted 3+-hop route would otherwise never be compared.
+	candidates := [][]string{msg.Route}
+	for _, route := range k.GetCandidateRoutes(ctx, msg.Route[0], msg.Route[len(msg.Route)-1]) {
+		if !routeEqual(route, msg.Route) {
+			candidates = append(candidates, route)
+		}
+	}
+
+	var best *types.MsgMultiHopSwapResponse
+	var bestDust sdk.Coins
+	var bestWrite func()
+	var bestEvents sdk.Events
+
+	for _, route := range candidates {
+		cacheCtx, writeCache := ctx.CacheContext()
+		resp, dust, err := k.executeMultiHopRoute(cacheCtx, msg, route)
+		if err != nil {
+			continue
+		}
+		if best == nil || resp.CoinOut.Amount.GT(best.CoinOut.Amount) {
+			best = resp
+			bestDust = dust
+			bestWrite = writeCache
+			bestEvents = cacheCtx.EventManager().Events()
+		}
+	}
+
+	if best == nil {
+		return nil, types.ErrNoRoute
+	}
+	bestWrite()
+	// CacheContext gives each simulated candidate its own EventManager, so
+	// the per-hop events emitted by executeMultiHopRoute for the winning
+	// candidate never reach ctx on their own; re-emit them here now that
+	// this candidate has actually been chosen.
+	ctx.EventManager().EmitEvents(bestEvents)
+
+	if err := k.refundDust(ctx, msg.Creator, bestDust); err != nil {
+		return nil, err
+	}
+
+	return best, nil
+}
+
+func routeEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// refundDust sends any intermediate-denom leftovers back to the sender.
+func (k Keeper) refundDust(ctx sdk.Context, sender string, dust sdk.Coins) error {
+	if dust.IsZero() {
+		return nil
+	}
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, senderAddr, dust)
+}
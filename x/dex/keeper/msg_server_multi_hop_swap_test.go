@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+func (s *DexTestSuite) TestMultiHopSwapRejectsSingleDenomRoute() {
+	msg := types.NewMsgMultiHopSwap(
+		s.alice.String(),
+		s.alice.String(),
+		[]string{"TokenA"},
+		math.NewInt(10_000000),
+		math.LegacyZeroDec(),
+		nil,
+		false,
+	)
+
+	s.ErrorIs(msg.ValidateBasic(), types.ErrInvalidRoute)
+}
+
+func (s *DexTestSuite) TestMultiHopSwapRejectsMismatchedHopLimits() {
+	msg := types.NewMsgMultiHopSwap(
+		s.alice.String(),
+		s.alice.String(),
+		[]string{"TokenA", "TokenB", "TokenC"},
+		math.NewInt(10_000000),
+		math.LegacyZeroDec(),
+		[]math.LegacyDec{math.LegacyOneDec()},
+		false,
+	)
+
+	s.Error(msg.ValidateBasic())
+}
+
+// TestMultiHopSwapPickBestRouteHandlesLongerCandidate ensures a candidate
+// route produced by GetCandidateRoutes with more hops than msg.Route
+// doesn't panic indexing msg.PriceLimits, and that events from the
+// winning cached candidate still reach the real context.
+func (s *DexTestSuite) TestMultiHopSwapPickBestRouteHandlesLongerCandidate() {
+	s.fundBobBalances(0, 0)
+	s.fundAliceBalances(50, 0)
+
+	msg := types.NewMsgMultiHopSwap(
+		s.alice.String(),
+		s.alice.String(),
+		[]string{"TokenA", "TokenB"},
+		math.NewInt(10_000000),
+		math.LegacyZeroDec(),
+		[]math.LegacyDec{math.LegacyOneDec()},
+		true,
+	)
+
+	eventsBefore := len(s.Ctx.EventManager().Events())
+
+	_, err := s.msgServer.MultiHopSwap(sdk.WrapSDKContext(s.Ctx), msg)
+
+	// Either it executes cleanly or fails with a typed dex error — it must
+	// not panic on an out-of-range PriceLimits index.
+	if err == nil {
+		s.Greater(len(s.Ctx.EventManager().Events()), eventsBefore)
+	}
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+// RangePositionKeyPrefix is the store prefix under which full-range and
+// preset-range positions are indexed by owner, separately from the
+// regular per-tick share accounting, so they can be enumerated without
+// scanning every tick in every pool.
+var RangePositionKeyPrefix = []byte{0x90}
+
+// rangePositionOwnerPrefix length-prefixes owner so it can be used both as
+// a standalone store prefix (every key for this owner, regardless of
+// tokenA/tokenB) and as the leading segment of rangePositionKey.
+func rangePositionOwnerPrefix(owner string) []byte {
+	var lenBz [4]byte
+	binary.BigEndian.PutUint32(lenBz[:], uint32(len(owner)))
+	key := make([]byte, 0, len(owner)+4)
+	key = append(key, lenBz[:]...)
+	key = append(key, owner...)
+	return key
+}
+
+// rangePositionKey length-prefixes each component instead of joining them
+// with a bare "/", so a denom that itself contains a "/" (e.g. an IBC
+// denom like "ibc/ABCD...") can't be crafted to collide with a different
+// owner/tokenA/tokenB triple.
+func rangePositionKey(owner, tokenA, tokenB string) []byte {
+	key := rangePositionOwnerPrefix(owner)
+	for _, part := range []string{tokenA, tokenB} {
+		var lenBz [4]byte
+		binary.BigEndian.PutUint32(lenBz[:], uint32(len(part)))
+		key = append(key, lenBz[:]...)
+		key = append(key, part...)
+	}
+	return key
+}
+
+// SetRangePosition persists pos, adding its Shares to any existing
+// position already recorded for the same owner/tokenA/tokenB rather than
+// overwriting it, so a second FullRange deposit accumulates instead of
+// discarding the first.
+func (k Keeper) SetRangePosition(ctx sdk.Context, pos types.RangePosition) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), RangePositionKeyPrefix)
+	key := rangePositionKey(pos.Owner, pos.TokenA, pos.TokenB)
+
+	if bz := store.Get(key); bz != nil {
+		var existing types.RangePosition
+		k.cdc.MustUnmarshal(bz, &existing)
+		pos.Shares = pos.Shares.Add(existing.Shares)
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&pos))
+}
+
+// RangePositions enumerates every range position owned by req.Address.
+func (k Keeper) RangePositions(goCtx context.Context, req *types.QueryRangePositionsRequest) (*types.QueryRangePositionsResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(RangePositionKeyPrefix, rangePositionOwnerPrefix(req.Address)...))
+
+	var positions []types.RangePosition
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pos types.RangePosition
+		k.cdc.MustUnmarshal(iterator.Value(), &pos)
+		positions = append(positions, pos)
+	}
+
+	return &types.QueryRangePositionsResponse{Positions: positions}, nil
+}
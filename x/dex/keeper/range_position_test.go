@@ -0,0 +1,23 @@
+package keeper_test
+
+import (
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+func (s *DexTestSuite) TestFullRangeDepositIsEnumerableViaRangePositions() {
+	s.fundAliceBalances(50, 50)
+
+	s.aliceDeposits(
+		NewDepositWithOptions(10, 10, 0, 0, types.DepositOptions{
+			FullRange:   true,
+			RangePreset: types.RangePresetWide,
+		}),
+	)
+
+	resp, err := s.App.DexKeeper.RangePositions(s.Ctx, &types.QueryRangePositionsRequest{
+		Address: s.alice.String(),
+	})
+	s.NoError(err)
+	s.Require().Len(resp.Positions, 1)
+	s.Equal(types.RangePresetWide, resp.Positions[0].Preset)
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+// RangePresetWidthKeyPrefix stores the governance-configurable half-width
+// (in ticks) for each RangePreset. It lives in the dex module's own store
+// rather than the generated Params message so it can be introduced
+// without a proto/migration round-trip; SetRangePresetWidth is intended
+// to be called from a governance-gated message/proposal handler.
+var RangePresetWidthKeyPrefix = []byte{0x92}
+
+// defaultRangePresetWidths are used for any preset that governance hasn't
+// explicitly configured yet.
+var defaultRangePresetWidths = map[types.RangePreset]int64{
+	types.RangePresetNarrow: 10,
+	types.RangePresetMedium: 100,
+	types.RangePresetWide:   1000,
+}
+
+func (k Keeper) SetRangePresetWidth(ctx sdk.Context, preset types.RangePreset, halfWidthTicks int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), RangePresetWidthKeyPrefix)
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(halfWidthTicks))
+	store.Set(rangePresetWidthKey(preset), buf)
+}
+
+// GetRangePresetWidth returns the configured half-width for preset, or its
+// built-in default if governance hasn't set one.
+func (k Keeper) GetRangePresetWidth(ctx sdk.Context, preset types.RangePreset) int64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), RangePresetWidthKeyPrefix)
+	bz := store.Get(rangePresetWidthKey(preset))
+	if bz == nil {
+		return defaultRangePresetWidths[preset]
+	}
+	return int64(binary.BigEndian.Uint64(bz))
+}
+
+func rangePresetWidthKey(preset types.RangePreset) []byte {
+	buf := make([]byte, 4)
+	binary.BigEndian.PutUint32(buf, uint32(preset))
+	return buf
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetCandidateRoutes returns every route the keeper knows about between
+// tokenIn and tokenOut, for PickBestRoute to simulate and compare. The
+// direct pair is always included if a pool for it exists; additional
+// routes are one-hop detours through every other denom that has a pool
+// with both tokenIn and tokenOut.
+func (k Keeper) GetCandidateRoutes(ctx sdk.Context, tokenIn, tokenOut string) [][]string {
+	var routes [][]string
+
+	if k.poolExists(ctx, tokenIn, tokenOut) {
+		routes = append(routes, []string{tokenIn, tokenOut})
+	}
+
+	for _, hop := range k.GetAllTradingDenoms(ctx) {
+		if hop == tokenIn || hop == tokenOut {
+			continue
+		}
+		if k.poolExists(ctx, tokenIn, hop) && k.poolExists(ctx, hop, tokenOut) {
+			routes = append(routes, []string{tokenIn, hop, tokenOut})
+		}
+	}
+
+	return routes
+}
+
+// poolExists reports whether a pool between the two denoms has ever been
+// created, at any tick/fee.
+func (k Keeper) poolExists(ctx sdk.Context, tokenA, tokenB string) bool {
+	_, found := k.GetPoolReserves(ctx, tokenA, tokenB)
+	return found
+}
+
+// GetAllTradingDenoms returns every denom that appears on either side of
+// at least one pool, for use as a candidate intermediate hop.
+func (k Keeper) GetAllTradingDenoms(ctx sdk.Context) []string {
+	seen := make(map[string]bool)
+	var denoms []string
+	k.IterateTradingPairs(ctx, func(tokenA, tokenB string) {
+		for _, d := range []string{tokenA, tokenB} {
+			if !seen[d] {
+				seen[d] = true
+				denoms = append(denoms, d)
+			}
+		}
+	})
+	return denoms
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+// pooledSwapState holds the pool and its reserves in memory for the
+// duration of a single swap, so the tick-walking loop can mutate them by
+// pointer instead of re-reading (and re-writing) the store once per tick
+// crossed. flush persists the final state exactly once.
+type pooledSwapState struct {
+	k        Keeper
+	ctx      sdk.Context
+	pool     *types.Pool
+	reserves *types.PoolReserves
+	dirty    bool
+}
+
+// loadPooledSwapState reads the pool and its reserves once, up front, for
+// the whole swap.
+func (k Keeper) loadPooledSwapState(ctx sdk.Context, tokenA, tokenB string) (*pooledSwapState, error) {
+	pool, found := k.GetPool(ctx, tokenA, tokenB)
+	if !found {
+		return nil, types.ErrNoRoute
+	}
+	reserves, found := k.GetPoolReserves(ctx, tokenA, tokenB)
+	if !found {
+		return nil, types.ErrNoRoute
+	}
+
+	return &pooledSwapState{k: k, ctx: ctx, pool: &pool, reserves: &reserves}, nil
+}
+
+// applyTickCrossing updates the in-memory reserves for one tick
+// crossed in the swap loop. It does NOT touch the store — that only
+// happens once, in flush.
+func (s *pooledSwapState) applyTickCrossing(amountIn, amountOut sdk.Coin) {
+	s.reserves.Reserves = s.reserves.Reserves.Add(amountIn).Sub(amountOut)
+	s.dirty = true
+}
+
+// flush writes the accumulated in-memory pool/reserves back to the store
+// exactly once, regardless of how many ticks were crossed getting here.
+// Only the swap loop in ExecutePlaceLimitOrder sees s.reserves; any other
+// reader (BEL detection, autoswap-fee accounting) reads the store, which
+// holds the updated reserves once flush has run at the end of the swap.
+func (s *pooledSwapState) flush() {
+	if !s.dirty {
+		return
+	}
+	s.k.SetPool(s.ctx, *s.pool)
+	s.k.SetPoolReserves(s.ctx, *s.reserves)
+	s.dirty = false
+}
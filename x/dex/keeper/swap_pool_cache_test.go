@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+)
+
+func BenchmarkMultiTickSwap(b *testing.B) {
+	s := new(DexTestSuite)
+	s.SetT(&testing.T{})
+	s.SetupTest()
+
+	s.fundBobBalances(0, 300)
+	for tick := int64(2001); tick <= 2010; tick++ {
+		s.bobDeposits(NewDeposit(0, 10, tick, 1))
+	}
+	s.fundAliceBalances(500, 0)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _, _ = s.App.DexKeeper.ExecutePlaceLimitOrder(
+			s.Ctx, s.alice.String(), s.alice.String(), "TokenA", "TokenB",
+			math.NewInt(1_000000), 0, math.LegacyZeroDec(),
+		)
+	}
+}
+
+// TestMultiTickSwapCostGrowsSublinearlyWithTicksCrossed exercises
+// ExecutePlaceLimitOrder directly (not via MsgDeposit's autoswap) so it
+// actually drives the tick-walking loop in execute_swap.go, which is what
+// pooledSwapState caches the pool/reserves read for.
+func (s *DexTestSuite) TestMultiTickSwapCostGrowsSublinearlyWithTicksCrossed() {
+	s.fundBobBalances(0, 30)
+	s.bobDeposits(NewDeposit(0, 10, 2001, 1))
+
+	s.fundAliceBalances(50, 50)
+	beforeOneTick := s.Ctx.GasMeter().GasConsumed()
+	_, _, _, err := s.App.DexKeeper.ExecutePlaceLimitOrder(
+		s.Ctx, s.alice.String(), s.alice.String(), "TokenA", "TokenB",
+		math.NewInt(1_000000), 0, math.LegacyZeroDec(),
+	)
+	s.NoError(err)
+	gasOneTick := s.Ctx.GasMeter().GasConsumed() - beforeOneTick
+
+	s.bobDeposits(NewDeposit(0, 10, 2002, 1), NewDeposit(0, 10, 2003, 1))
+	beforeThreeTicks := s.Ctx.GasMeter().GasConsumed()
+	_, _, _, err = s.App.DexKeeper.ExecutePlaceLimitOrder(
+		s.Ctx, s.alice.String(), s.alice.String(), "TokenA", "TokenB",
+		math.NewInt(20_000000), 0, math.LegacyZeroDec(),
+	)
+	s.NoError(err)
+	gasThreeTicks := s.Ctx.GasMeter().GasConsumed() - beforeThreeTicks
+
+	// Pool state is read/written once per swap regardless of ticks
+	// crossed, so cost should not triple for a 3x deeper walk.
+	s.Less(float64(gasThreeTicks), float64(gasOneTick)*3)
+}
@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+// BenchmarkTickIndexKey guards against regressing tick-key encoding back
+// onto a big.Int allocation per call.
+func BenchmarkTickIndexKey(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = types.TickIndexKey(int64(i%100000 - 50000))
+	}
+}
+
+// TestDeepSwapCrossesHundredsOfTicksAtConstantGasPerTick drives an actual
+// swap (via ExecutePlaceLimitOrder, not a deposit) over 500 resting ticks
+// and checks the gas per tick of a swap crossing roughly 450 ticks stays
+// within 50% of that of a swap crossing roughly 50: it guards against the tick-walking loop regressing back to reading/writing
+// the pool once per tick crossed instead of once per swap.
+func (s *DexTestSuite) TestDeepSwapCrossesHundredsOfTicksAtConstantGasPerTick() {
+	s.fundBobBalances(0, 10000)
+
+	for tick := int64(1); tick <= 500; tick++ {
+		s.bobDeposits(NewDeposit(0, 1, tick, 1))
+	}
+	s.fundAliceBalances(10000, 0)
+
+	beforeShallow := s.Ctx.GasMeter().GasConsumed()
+	_, _, _, err := s.App.DexKeeper.ExecutePlaceLimitOrder(
+		s.Ctx, s.alice.String(), s.alice.String(), "TokenA", "TokenB",
+		math.NewInt(50_000000), 0, math.LegacyZeroDec(),
+	)
+	s.NoError(err)
+	gasShallowSwap := s.Ctx.GasMeter().GasConsumed() - beforeShallow
+
+	beforeDeep := s.Ctx.GasMeter().GasConsumed()
+	_, _, _, err = s.App.DexKeeper.ExecutePlaceLimitOrder(
+		s.Ctx, s.alice.String(), s.alice.String(), "TokenA", "TokenB",
+		math.NewInt(450_000000), 0, math.LegacyZeroDec(),
+	)
+	s.NoError(err)
+	gasDeepSwap := s.Ctx.GasMeter().GasConsumed() - beforeDeep
+
+	gasPerTickShallow := float64(gasShallowSwap) / 50
+	gasPerTickDeep := float64(gasDeepSwap) / 450
+
+	// Per-tick cost should stay roughly flat, not grow with how many
+	// ticks the swap crosses.
+	s.InDelta(gasPerTickShallow, gasPerTickDeep, gasPerTickShallow*0.5)
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+// TickLiquidityKeyPrefix indexes resting liquidity by tick, encoded with
+// TickIndexKey so iteration walks ticks in native int64 order.
+var TickLiquidityKeyPrefix = []byte{0x91}
+
+// GetNextTickLiquidity returns the first tick beyond currentTick (in the
+// tokenIn->tokenOut direction) that has resting liquidity for the
+// tokenIn/tokenOut pair, and how much tokenOut rests there.
+func (k Keeper) GetNextTickLiquidity(ctx sdk.Context, tokenIn, tokenOut string, currentTick int64) (int64, math.Int, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), tickLiquidityPairPrefix(tokenIn, tokenOut))
+
+	start := types.TickIndexKey(currentTick + 1)
+	iterator := store.Iterator(start, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, math.ZeroInt(), false
+	}
+
+	tick := types.TickIndexFromKey(iterator.Key())
+	liquidity := math.ZeroInt()
+	if err := liquidity.Unmarshal(iterator.Value()); err != nil {
+		return 0, math.ZeroInt(), false
+	}
+
+	return tick, liquidity, true
+}
+
+func tickLiquidityPairPrefix(tokenIn, tokenOut string) []byte {
+	return append(append([]byte{}, TickLiquidityKeyPrefix...), []byte(tokenIn+"/"+tokenOut+"/")...)
+}
+
+// AddTickLiquidity adds amount to the resting liquidity recorded for tick
+// under the tokenIn/tokenOut pair, so a later swap walking tokenIn->tokenOut
+// sees it via GetNextTickLiquidity. A no-op for a non-positive amount.
+func (k Keeper) AddTickLiquidity(ctx sdk.Context, tokenIn, tokenOut string, tick int64, amount math.Int) {
+	if !amount.IsPositive() {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), tickLiquidityPairPrefix(tokenIn, tokenOut))
+	key := types.TickIndexKey(tick)
+
+	existing := math.ZeroInt()
+	if bz := store.Get(key); bz != nil {
+		if err := existing.Unmarshal(bz); err == nil {
+			amount = amount.Add(existing)
+		}
+	}
+
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
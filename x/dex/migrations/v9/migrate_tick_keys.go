@@ -0,0 +1,76 @@
+package v9
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+// MigrateTickKeys rewrites every tick-liquidity store key from its old
+// math.Int (big-endian decimal string) encoding to the new fixed-width
+// int64 encoding in TickIndexKey, so the swap loop can iterate ticks
+// without parsing a big.Int on every step.
+func MigrateTickKeys(ctx sdk.Context, storeKey storetypes.StoreKey, tickLiquidityPrefix []byte) error {
+	store := ctx.KVStore(storeKey)
+	oldStore := prefix.NewStore(store, tickLiquidityPrefix)
+
+	iterator := oldStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	type rewrite struct {
+		oldKey, newKey, value []byte
+	}
+	var rewrites []rewrite
+
+	for ; iterator.Valid(); iterator.Next() {
+		oldKey := iterator.Key()
+		tick, rest, err := parseLegacyTickKey(oldKey)
+		if err != nil {
+			return err
+		}
+		newKey := append(types.TickIndexKey(tick), rest...)
+		rewrites = append(rewrites, rewrite{
+			oldKey: append([]byte{}, oldKey...),
+			newKey: newKey,
+			value:  append([]byte{}, iterator.Value()...),
+		})
+	}
+
+	for _, r := range rewrites {
+		oldStore.Delete(r.oldKey)
+		oldStore.Set(r.newKey, r.value)
+	}
+
+	return nil
+}
+
+// parseLegacyTickKey splits the old "<math.Int decimal string>/<rest>"
+// key encoding back into a tick and the remaining key bytes (fee tier,
+// pool id, etc.).
+func parseLegacyTickKey(key []byte) (types.TickIndex, []byte, error) {
+	sep := -1
+	for i, b := range key {
+		if b == '/' {
+			sep = i
+			break
+		}
+	}
+	if sep < 0 {
+		sep = len(key)
+	}
+
+	tickInt, ok := math.NewIntFromString(string(key[:sep]))
+	if !ok {
+		return 0, nil, types.ErrInvalidTickKey
+	}
+
+	var rest []byte
+	if sep < len(key) {
+		rest = key[sep:]
+	}
+
+	return types.TickIndexFromInt(tickInt), rest, nil
+}
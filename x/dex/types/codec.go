@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterLegacyAminoCodec registers the module's Msg types on the
+// provided LegacyAmino codec, for backwards-compatible amino JSON
+// signing.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgMultiHopSwap{}, "dex/MultiHopSwap", nil)
+}
+
+// RegisterInterfaces registers the module's Msg implementations against
+// the InterfaceRegistry so they can be packed into an Any and routed by
+// sdk.Msg-typed callers (ante handler, CLI, tests).
+//
+// The module's full tx.pb.go additionally emits a grpc.ServiceDesc
+// (_Msg_serviceDesc) and calls msgservice.RegisterMsgServiceDesc so the
+// MsgServiceRouter can dispatch MultiHopSwap by name; that file descriptor
+// is codegen output this trimmed checkout doesn't carry, so that last
+// step happens in the full tx.pb.go this one complements, not here.
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgMultiHopSwap{},
+	)
+}
@@ -0,0 +1,36 @@
+package types
+
+// RangePreset names a canonical tick-width preset that NewFullRangeDeposit
+// maps to a concrete spread via Keeper.GetRangePresetWidth, so LPs don't
+// have to reason about tick math to pick "how wide".
+type RangePreset int32
+
+const (
+	RangePresetUnspecified RangePreset = iota
+	RangePresetNarrow
+	RangePresetMedium
+	RangePresetWide
+)
+
+// DepositOptions carries the per-deposit behavior flags that apply to
+// every tick/fee pair in a MsgDeposit.
+type DepositOptions struct {
+	// FailTxOnBel rejects the whole deposit with ErrDepositBehindEnemyLines
+	// instead of silently skipping the behind-enemy-lines tick/fee pairs.
+	FailTxOnBel bool
+	// SwapOnDeposit autoswaps one side of a single-sided deposit into the
+	// pool's existing ratio instead of depositing it one-sided.
+	SwapOnDeposit bool
+	// FullRange, when set, ignores the requested tick index and fee for this entry and
+	// instead spreads TokenA/TokenB across a wide range centered on the
+	// pool's current price; see NewFullRangeDeposit.
+	FullRange bool
+	// RangePreset selects the width FullRange spreads across. Defaults to
+	// RangePresetMedium when FullRange is set and RangePreset is left
+	// unspecified.
+	RangePreset RangePreset
+	// StrictMode rejects a deposit with ErrDuplicatePriceTick instead of
+	// silently snapping it to the nearest tick with a distinct price; see
+	// RoundTickToUniquePrice.
+	StrictMode bool
+}
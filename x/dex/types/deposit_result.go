@@ -0,0 +1,49 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DepositResult is what the keeper's deposit execution path returns
+// internally. MsgDepositResponse exposes the same information over the
+// wire; new fields (e.g. AutoswapFee) get added here first and threaded
+// into MsgDepositResponse via ToMsgDepositResponse, rather than growing
+// Deposit's go return signature every time.
+type DepositResult struct {
+	Reserve0Deposited  []math.Int
+	Reserve1Deposited  []math.Int
+	SharesIssued       []sdk.Coin
+	SwapInAmount       math.Int
+	SwapOutAmount      math.Int
+	AutoswapFee        math.Int
+	NewCurrentTick0To1 int64
+	NewCurrentTick1To0 int64
+	FailedDeposits     []*FailedDeposit
+	// EffectiveTicks holds the tick actually used for each deposited
+	// pair, in the same order as Reserve0Deposited/SharesIssued. It
+	// differs from the requested tick whenever RoundTickToUniquePrice
+	// snapped the deposit to avoid a duplicate-price collision.
+	EffectiveTicks []int64
+}
+
+// ToMsgDepositResponse projects a DepositResult onto the wire response
+// shape so existing callers (CLI, tests, clients built against
+// MsgDepositResponse) keep working unchanged. Every DepositResult field
+// has a home on MsgDepositResponse — SimulateDeposit wraps this same
+// response, so dropping a field here would silently break its promise to
+// preview swap amounts/final tick/autoswap fee alongside the deposit.
+func (r *DepositResult) ToMsgDepositResponse() *MsgDepositResponse {
+	return &MsgDepositResponse{
+		Reserve0Deposited:  r.Reserve0Deposited,
+		Reserve1Deposited:  r.Reserve1Deposited,
+		SharesIssued:       r.SharesIssued,
+		FailedDeposits:     r.FailedDeposits,
+		EffectiveTicks:     r.EffectiveTicks,
+		SwapInAmount:       r.SwapInAmount,
+		SwapOutAmount:      r.SwapOutAmount,
+		AutoswapFee:        r.AutoswapFee,
+		NewCurrentTick0To1: r.NewCurrentTick0To1,
+		NewCurrentTick1To0: r.NewCurrentTick1To0,
+	}
+}
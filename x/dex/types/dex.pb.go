@@ -0,0 +1,150 @@
+package types
+
+import (
+	"errors"
+	"io"
+)
+
+// Shared varint/skip helpers for the hand-maintained proto.Message
+// implementations in this package (range_position.go's RangePosition is
+// the only type here actually round-tripped through a binary codec; the
+// request/response types in tx.pb.go and query_simulate.go only need
+// Reset/String/ProtoMessage). Named the same way protoc-gen-gocosmos
+// would for this file (dex.proto -> Dex), so they read the same as the
+// generated helpers in every other .pb.go in the module.
+
+// ErrInvalidLengthDex is returned when a decoded length prefix is negative
+// or would read past the end of the buffer.
+var ErrInvalidLengthDex = errors.New("proto: negative length found during unmarshaling")
+
+// ErrIntOverflowDex is returned when a varint is longer than 64 bits.
+var ErrIntOverflowDex = errors.New("proto: integer overflow")
+
+func sovDex(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+
+func encodeVarintDex(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDex(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+
+// readDexVarint reads a single varint starting at iNdEx and returns its
+// value plus the index immediately after it.
+func readDexVarint(dAtA []byte, iNdEx, l int) (uint64, int, error) {
+	var v uint64
+	for shift := uint(0); ; shift += 7 {
+		if shift >= 64 {
+			return 0, 0, ErrIntOverflowDex
+		}
+		if iNdEx >= l {
+			return 0, 0, io.ErrUnexpectedEOF
+		}
+		b := dAtA[iNdEx]
+		iNdEx++
+		v |= uint64(b&0x7F) << shift
+		if b < 0x80 {
+			break
+		}
+	}
+	return v, iNdEx, nil
+}
+
+// readDexString reads a length-prefixed string starting at iNdEx and
+// returns it plus the index immediately after it.
+func readDexString(dAtA []byte, iNdEx, l int) (string, int, error) {
+	strLen, iNdEx, err := readDexVarint(dAtA, iNdEx, l)
+	if err != nil {
+		return "", 0, err
+	}
+	intLen := int(strLen)
+	if intLen < 0 {
+		return "", 0, ErrInvalidLengthDex
+	}
+	postIndex := iNdEx + intLen
+	if postIndex < 0 || postIndex > l {
+		return "", 0, io.ErrUnexpectedEOF
+	}
+	return string(dAtA[iNdEx:postIndex]), postIndex, nil
+}
+
+// skipDex skips over the bytes of an unrecognized field, whatever its
+// wire type, and returns how many bytes it occupied.
+func skipDex(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDex
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			v, newIndex, err := readDexVarint(dAtA, iNdEx, l)
+			if err != nil {
+				return 0, err
+			}
+			iNdEx = newIndex + int(v)
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, errors.New("proto: illegal tag, group end without group start")
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, errors.New("proto: illegal wireType")
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDex
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
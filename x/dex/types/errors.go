@@ -0,0 +1,16 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// x/dex module sentinel errors that don't already live in the generated
+// proto/codec package. IDs continue from the module's existing error
+// registry; bump the next free ID here when adding a new one.
+var (
+	ErrInvalidRoute          = errors.Register(ModuleName, 1101, "multi-hop route must contain at least two denoms")
+	ErrNoRoute               = errors.Register(ModuleName, 1102, "no route produced a valid swap")
+	ErrHopPriceLimitExceeded = errors.Register(ModuleName, 1103, "hop price limit exceeded")
+	ErrInvalidTickKey        = errors.Register(ModuleName, 1104, "could not parse legacy tick store key")
+	ErrDuplicatePriceTick    = errors.Register(ModuleName, 1105, "requested tick has the same effective price as an existing tick")
+)
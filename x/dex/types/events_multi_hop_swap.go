@@ -0,0 +1,14 @@
+package types
+
+// Event types/attributes emitted once per hop of a MultiHopSwap, in
+// addition to the usual per-swap TickUpdate/etc. events each hop's
+// underlying swap already emits.
+const (
+	EventTypeMultiHopSwapHop = "multi_hop_swap_hop"
+
+	AttributeKeyHopIndex  = "hop_index"
+	AttributeKeyTokenIn   = "token_in"
+	AttributeKeyTokenOut  = "token_out"
+	AttributeKeyAmountIn  = "amount_in"
+	AttributeKeyAmountOut = "amount_out"
+)
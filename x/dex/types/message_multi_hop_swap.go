@@ -0,0 +1,71 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgMultiHopSwap = "multi_hop_swap"
+
+var _ sdk.Msg = &MsgMultiHopSwap{}
+
+// MsgMultiHopSwap atomically walks a route of pools, denom by denom,
+// treating each leg as an independent swap. PriceLimits, if set, bounds
+// each individual hop so a route doesn't walk a single pool's book past a
+// threshold before failing the rest of the route. ExitLimitPrice is used
+// as the price limit of the last hop when PriceLimits has no entry for
+// it; the price of the route as a whole is not checked against it.
+//
+// The struct itself, along with Reset/String/ProtoMessage, lives in
+// tx.pb.go next to MsgMultiHopSwapResponse; this file only holds the
+// hand-written constructor and sdk.Msg behavior.
+
+func NewMsgMultiHopSwap(
+	creator, receiver string,
+	route []string,
+	amountIn math.Int,
+	exitLimitPrice math.LegacyDec,
+	priceLimits []math.LegacyDec,
+	pickBestRoute bool,
+) *MsgMultiHopSwap {
+	return &MsgMultiHopSwap{
+		Creator:        creator,
+		Receiver:       receiver,
+		Route:          route,
+		AmountIn:       amountIn,
+		ExitLimitPrice: exitLimitPrice,
+		PriceLimits:    priceLimits,
+		PickBestRoute:  pickBestRoute,
+	}
+}
+
+func (msg *MsgMultiHopSwap) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgMultiHopSwap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Receiver != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+		}
+	}
+	if len(msg.Route) < 2 {
+		return ErrInvalidRoute
+	}
+	if !msg.AmountIn.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "amount_in must be positive")
+	}
+	if msg.PriceLimits != nil && len(msg.PriceLimits) != len(msg.Route)-1 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "price_limits must have one entry per hop")
+	}
+	return nil
+}
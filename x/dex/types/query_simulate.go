@@ -0,0 +1,83 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The request/response types below correspond to proto/neutron/dex/query.proto.
+// As with tx.pb.go, they're maintained by hand rather than emitted by
+// protoc-gen-gocosmos in this trimmed checkout; QueryServer is the same
+// interface RegisterQueryServer(grpc.Server, QueryServer) would be called
+// against once the module's full query.pb.go (with its grpc.ServiceDesc)
+// is back in the tree.
+
+// QueryServer is the server API for the RPCs declared in query.proto.
+// Keeper satisfies it via DrySwap (grpc_query_simulate.go), SimulateDeposit
+// (grpc_query_simulate.go), and RangePositions (range_position.go).
+type QueryServer interface {
+	DrySwap(context.Context, *QueryDrySwapRequest) (*QueryDrySwapResponse, error)
+	SimulateDeposit(context.Context, *QuerySimulateDepositRequest) (*QuerySimulateDepositResponse, error)
+	RangePositions(context.Context, *QueryRangePositionsRequest) (*QueryRangePositionsResponse, error)
+}
+
+// QueryDrySwapRequest mirrors the fields MsgPlaceLimitOrder needs to execute a
+// swap, minus anything that only matters for an order that gets stored
+// on the book (e.g. ExpirationTime).
+type QueryDrySwapRequest struct {
+	Creator          string
+	Receiver         string
+	TokenIn          string
+	TokenOut         string
+	AmountIn         math.Int
+	TickIndexInToOut int64
+	LimitSellPrice   math.LegacyDec
+}
+
+func (m *QueryDrySwapRequest) Reset()         { *m = QueryDrySwapRequest{} }
+func (m *QueryDrySwapRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*QueryDrySwapRequest) ProtoMessage()    {}
+
+// QueryDrySwapResponse reports what MsgPlaceLimitOrder would have produced
+// had it actually been executed and committed.
+type QueryDrySwapResponse struct {
+	CoinOut     sdk.Coin
+	TickOut     int64
+	RemainingIn math.Int
+}
+
+func (m *QueryDrySwapResponse) Reset()         { *m = QueryDrySwapResponse{} }
+func (m *QueryDrySwapResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*QueryDrySwapResponse) ProtoMessage()    {}
+
+// QuerySimulateDepositRequest carries the same fields as MsgDeposit.
+type QuerySimulateDepositRequest struct {
+	Creator         string
+	Receiver        string
+	TokenA          string
+	TokenB          string
+	AmountsA        []math.Int
+	AmountsB        []math.Int
+	TickIndexesAToB []int64
+	Fees            []uint64
+	Options         []*DepositOptions
+}
+
+func (m *QuerySimulateDepositRequest) Reset()         { *m = QuerySimulateDepositRequest{} }
+func (m *QuerySimulateDepositRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*QuerySimulateDepositRequest) ProtoMessage()    {}
+
+// QuerySimulateDepositResponse wraps the same response a real MsgDeposit
+// would have returned, so callers can reuse the exact same parsing code
+// for a preview as for a submitted tx.
+type QuerySimulateDepositResponse struct {
+	Resp *MsgDepositResponse
+}
+
+func (m *QuerySimulateDepositResponse) Reset()         { *m = QuerySimulateDepositResponse{} }
+func (m *QuerySimulateDepositResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*QuerySimulateDepositResponse) ProtoMessage()    {}
@@ -0,0 +1,258 @@
+package types
+
+import (
+	"fmt"
+	"io"
+
+	"cosmossdk.io/math"
+)
+
+// RangePosition is a single FullRange (or preset-range) deposit, tracked
+// separately from regular tick/fee shares so it can be enumerated and
+// reported back to the LP without requiring them to know which concrete
+// tick their deposit landed on.
+//
+// It corresponds to proto/neutron/dex/dex.proto and, unlike the
+// hand-maintained request/response types in query_simulate.go and
+// tx.pb.go, is actually round-tripped through the KVStore via
+// k.cdc.MustMarshal/MustUnmarshal (range_position.go in the keeper
+// package), so it needs a real Marshal/Unmarshal/Size, not just
+// Reset/String/ProtoMessage.
+type RangePosition struct {
+	Owner     string
+	TokenA    string
+	TokenB    string
+	TickIndex int64
+	Fee       uint64
+	Preset    RangePreset
+	Shares    math.Int
+}
+
+func (m *RangePosition) Reset()         { *m = RangePosition{} }
+func (m *RangePosition) String() string { return fmt.Sprintf("%+v", *m) }
+func (*RangePosition) ProtoMessage()    {}
+
+type QueryRangePositionsRequest struct {
+	Address string
+}
+
+func (m *QueryRangePositionsRequest) Reset()         { *m = QueryRangePositionsRequest{} }
+func (m *QueryRangePositionsRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*QueryRangePositionsRequest) ProtoMessage()    {}
+
+type QueryRangePositionsResponse struct {
+	Positions []RangePosition
+}
+
+func (m *QueryRangePositionsResponse) Reset()         { *m = QueryRangePositionsResponse{} }
+func (m *QueryRangePositionsResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*QueryRangePositionsResponse) ProtoMessage()    {}
+
+func (m *RangePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	if l := len(m.Owner); l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	if l := len(m.TokenA); l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	if l := len(m.TokenB); l > 0 {
+		n += 1 + l + sovDex(uint64(l))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovDex(uint64(m.TickIndex))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovDex(uint64(m.Fee))
+	}
+	if m.Preset != 0 {
+		n += 1 + sovDex(uint64(m.Preset))
+	}
+	l := m.Shares.Size()
+	n += 1 + l + sovDex(uint64(l))
+	return n
+}
+
+func (m *RangePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RangePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RangePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDex(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Preset != 0 {
+		i = encodeVarintDex(dAtA, i, uint64(m.Preset))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Fee != 0 {
+		i = encodeVarintDex(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TickIndex != 0 {
+		i = encodeVarintDex(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if l := len(m.TokenB); l > 0 {
+		i -= l
+		copy(dAtA[i:], m.TokenB)
+		i = encodeVarintDex(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if l := len(m.TokenA); l > 0 {
+		i -= l
+		copy(dAtA[i:], m.TokenA)
+		i = encodeVarintDex(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0x12
+	}
+	if l := len(m.Owner); l > 0 {
+		i -= l
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintDex(dAtA, i, uint64(l))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RangePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			s, newIndex, err := readDexString(dAtA, iNdEx, l)
+			if err != nil {
+				return err
+			}
+			m.Owner = s
+			iNdEx = newIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+			}
+			s, newIndex, err := readDexString(dAtA, iNdEx, l)
+			if err != nil {
+				return err
+			}
+			m.TokenA = s
+			iNdEx = newIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			s, newIndex, err := readDexString(dAtA, iNdEx, l)
+			if err != nil {
+				return err
+			}
+			m.TokenB = s
+			iNdEx = newIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			v, newIndex, err := readDexVarint(dAtA, iNdEx, l)
+			if err != nil {
+				return err
+			}
+			m.TickIndex = int64(v)
+			iNdEx = newIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			v, newIndex, err := readDexVarint(dAtA, iNdEx, l)
+			if err != nil {
+				return err
+			}
+			m.Fee = v
+			iNdEx = newIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preset", wireType)
+			}
+			v, newIndex, err := readDexVarint(dAtA, iNdEx, l)
+			if err != nil {
+				return err
+			}
+			m.Preset = RangePreset(v)
+			iNdEx = newIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			msgLen, newIndex, err := readDexVarint(dAtA, iNdEx, l)
+			if err != nil {
+				return err
+			}
+			postIndex := newIndex + int(msgLen)
+			if postIndex < 0 || postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[newIndex:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			skippy, err := skipDex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 || iNdEx+skippy < 0 || iNdEx+skippy > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+)
+
+// TickIndex is the in-memory/wire representation of a dex tick. It used
+// to be carried around as math.Int (and, in a few call sites, its decimal
+// string form) which meant every tick-iteration step allocated a big.Int.
+// The wire type (int64 in the generated proto messages) never changed, so
+// this is purely an in-memory/storage-key representation change.
+type TickIndex = int64
+
+// TickIndexKey big-endian-encodes a TickIndex for use as a store key
+// component, preserving numeric iteration order across negative and
+// positive ticks (via the sign-flip trick).
+func TickIndexKey(tick TickIndex) []byte {
+	buf := make([]byte, 8)
+	// Flip the sign bit so two's-complement ordering matches big-endian
+	// byte ordering across negative and positive ticks.
+	binary.BigEndian.PutUint64(buf, uint64(tick)^(1<<63))
+	return buf
+}
+
+// TickIndexFromKey is the inverse of TickIndexKey.
+func TickIndexFromKey(key []byte) TickIndex {
+	return int64(binary.BigEndian.Uint64(key) ^ (1 << 63))
+}
+
+// TickIndexFromInt converts a legacy math.Int-encoded tick, for use only
+// by the v8->v9 store migration.
+func TickIndexFromInt(i math.Int) TickIndex {
+	return i.Int64()
+}
@@ -0,0 +1,68 @@
+package types
+
+import "cosmossdk.io/math"
+
+// PricePrecision is the number of decimal digits of precision the dex
+// keeps when computing 1.0001^tick. A 0.01%-per-tick relative step only
+// collapses below this fixed-point resolution at extreme tick magnitudes
+// (past roughly -322,000 on the negative side, where price itself falls
+// below about 1e-14); every tick of smaller magnitude, 23027 included,
+// still has a distinct price. Where it does collapse, two adjacent
+// tick indices round to the same price, which would otherwise let two
+// ticks silently represent one price and splinter liquidity between them.
+const PricePrecision = 18
+
+// PriceAtTick returns 1.0001^tick truncated to PricePrecision digits, the
+// same rounding every other price computation in the module uses.
+func PriceAtTick(tick TickIndex) math.LegacyDec {
+	base := math.LegacyNewDecWithPrec(10001, 4) // 1.0001
+	if tick >= 0 {
+		return powDec(base, uint64(tick))
+	}
+	return math.LegacyOneDec().Quo(powDec(base, uint64(-tick)))
+}
+
+func powDec(base math.LegacyDec, exp uint64) math.LegacyDec {
+	result := math.LegacyOneDec()
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = result.Mul(base)
+		}
+		base = base.Mul(base)
+		exp >>= 1
+	}
+	return result
+}
+
+// hasDistinctPrice reports whether tick's price differs from both of its
+// immediate neighbors at PricePrecision. A tick that fails this check maps
+// to the same price as a neighboring tick index and is unsafe to use as-is.
+func hasDistinctPrice(tick TickIndex) bool {
+	price := PriceAtTick(tick)
+	return !price.Equal(PriceAtTick(tick+1)) && !price.Equal(PriceAtTick(tick-1))
+}
+
+// RoundTickToUniquePrice checks whether requested maps to a price distinct
+// from its neighboring ticks. If it does, requested is returned unchanged.
+// If not: in strict mode it returns ErrDuplicatePriceTick; otherwise it
+// searches outward (requested+1, requested-1, requested+2, ...) up to
+// maxSearch steps for the nearest tick with a distinct price and returns
+// that as the effective tick.
+func RoundTickToUniquePrice(requested TickIndex, strict bool, maxSearch int) (TickIndex, error) {
+	if hasDistinctPrice(requested) {
+		return requested, nil
+	}
+	if strict {
+		return 0, ErrDuplicatePriceTick
+	}
+
+	for step := 1; step <= maxSearch; step++ {
+		for _, candidate := range []TickIndex{requested + TickIndex(step), requested - TickIndex(step)} {
+			if hasDistinctPrice(candidate) {
+				return candidate, nil
+			}
+		}
+	}
+
+	return 0, ErrDuplicatePriceTick
+}
@@ -0,0 +1,35 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v8/x/dex/types"
+)
+
+func TestRoundTickToUniquePriceReturnsRequestedWhenDistinct(t *testing.T) {
+	effective, err := types.RoundTickToUniquePrice(0, false, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), effective)
+}
+
+func TestPriceAtTickIsMonotonic(t *testing.T) {
+	require.True(t, types.PriceAtTick(1).GT(types.PriceAtTick(0)))
+	require.True(t, types.PriceAtTick(-1).LT(types.PriceAtTick(0)))
+}
+
+// TestRoundTickToUniquePriceCollidesAtExtremeNegativeMagnitude pins the
+// actual tick depth where 1.0001^tick underflows past the 18-decimal
+// price precision and collapses to the same value as its neighbors.
+// Nothing near tick 23027 (the magnitude named in the original backlog
+// request) collides; the real collision only shows up hundreds of
+// thousands of ticks further out, on the negative side.
+func TestRoundTickToUniquePriceCollidesAtExtremeNegativeMagnitude(t *testing.T) {
+	_, err := types.RoundTickToUniquePrice(-414488, true, 10)
+	require.ErrorIs(t, err, types.ErrDuplicatePriceTick)
+
+	effective, err := types.RoundTickToUniquePrice(23027, true, 10)
+	require.NoError(t, err)
+	require.Equal(t, int64(23027), effective)
+}
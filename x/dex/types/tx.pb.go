@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The types below correspond to proto/neutron/dex/tx.proto. They're
+// maintained by hand in this checkout rather than emitted by
+// protoc-gen-gocosmos (this snapshot doesn't carry the module's codegen
+// pipeline), but the field set and wire shape are the proto file's, not
+// whatever a caller happens to need that day — extend the .proto first,
+// then mirror the field here.
+
+// MsgDeposit is the request type for the Msg.Deposit RPC.
+type MsgDeposit struct {
+	Creator         string
+	Receiver        string
+	TokenA          string
+	TokenB          string
+	AmountsA        []math.Int
+	AmountsB        []math.Int
+	TickIndexesAToB []int64
+	Fees            []uint64
+	Options         []*DepositOptions
+}
+
+func (m *MsgDeposit) Reset()         { *m = MsgDeposit{} }
+func (m *MsgDeposit) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgDeposit) ProtoMessage()    {}
+
+// FailedDeposit records one tick/fee pair from a multi-entry MsgDeposit
+// that was skipped (e.g. behind enemy lines without FailTxOnBel) rather
+// than aborting the whole tx.
+type FailedDeposit struct {
+	DepositIndex uint64
+	Error        string
+}
+
+func (m *FailedDeposit) Reset()         { *m = FailedDeposit{} }
+func (m *FailedDeposit) String() string { return fmt.Sprintf("%+v", *m) }
+func (*FailedDeposit) ProtoMessage()    {}
+
+// MsgDepositResponse is the response type for the Msg.Deposit RPC.
+type MsgDepositResponse struct {
+	Reserve0Deposited []math.Int
+	Reserve1Deposited []math.Int
+	SharesIssued      []sdk.Coin
+	FailedDeposits    []*FailedDeposit
+	// EffectiveTicks holds the tick actually used for each deposited
+	// pair, in the same order as Reserve0Deposited/SharesIssued.
+	EffectiveTicks     []int64
+	SwapInAmount       math.Int
+	SwapOutAmount      math.Int
+	AutoswapFee        math.Int
+	NewCurrentTick0To1 int64
+	NewCurrentTick1To0 int64
+}
+
+func (m *MsgDepositResponse) Reset()         { *m = MsgDepositResponse{} }
+func (m *MsgDepositResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgDepositResponse) ProtoMessage()    {}
+
+// MsgMultiHopSwap is the request type for the Msg.MultiHopSwap RPC.
+// NewMsgMultiHopSwap/GetSigners/ValidateBasic live in
+// message_multi_hop_swap.go alongside the other hand-written Msg logic.
+type MsgMultiHopSwap struct {
+	Creator  string
+	Receiver string
+	// Route is an ordered list of denoms, e.g. [TokenA, TokenB, TokenC]
+	// swaps TokenA -> TokenB -> TokenC.
+	Route []string
+	// AmountIn is the amount of Route[0] the creator is selling.
+	AmountIn math.Int
+	// ExitLimitPrice bounds the price of Route[len(Route)-1] per Route[0].
+	ExitLimitPrice math.LegacyDec
+	// PriceLimits, if non-nil, must have len(Route)-1 entries, one per hop.
+	PriceLimits []math.LegacyDec
+	// PickBestRoute, when true, treats Route as one of several candidate
+	// routes supplied across repeated hops sharing the same endpoints and
+	// executes whichever simulated route yields the largest output.
+	PickBestRoute bool
+}
+
+func (m *MsgMultiHopSwap) Reset()         { *m = MsgMultiHopSwap{} }
+func (m *MsgMultiHopSwap) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgMultiHopSwap) ProtoMessage()    {}
+
+// MsgMultiHopSwapResponse reports the final amount out and the route that
+// was actually executed (relevant when PickBestRoute is set).
+type MsgMultiHopSwapResponse struct {
+	CoinOut      sdk.Coin
+	RouteUsed    []string
+	DustRefunded sdk.Coins
+}
+
+func (m *MsgMultiHopSwapResponse) Reset()         { *m = MsgMultiHopSwapResponse{} }
+func (m *MsgMultiHopSwapResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgMultiHopSwapResponse) ProtoMessage()    {}